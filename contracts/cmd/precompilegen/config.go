@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the precompile to be generated: which fixed EVM address it is registered at,
+// which Cosmos module it wraps, and the Go package/bindings it should be emitted into.
+type Config struct {
+	// Module is the Cosmos SDK module name as it is capitalized in the bindings (e.g. "Gov"), used
+	// verbatim in doc comments and the generated `generated.<Module>ModuleMetaData` reference.
+	Module string `yaml:"module"`
+	// Package is the Go package name for the generated contract, e.g. "gov".
+	Package string `yaml:"package"`
+	// Address is the fixed precompile address, as a `0x`-prefixed hex string.
+	Address string `yaml:"address"`
+	// BindingsImport is the Go import path of the generated ABI bindings package, whose
+	// `<Module>ModuleMetaData.ABI` is passed to `ethprecompile.NewBaseContract`.
+	BindingsImport string `yaml:"bindingsImport"`
+}
+
+// loadConfig reads and validates a precompilegen config from path.
+func loadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	var cfg Config
+	if err = yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	if cfg.Module == "" || cfg.Package == "" || cfg.Address == "" || cfg.BindingsImport == "" {
+		return nil, fmt.Errorf("config must set module, package, address, and bindingsImport")
+	}
+
+	return &cfg, nil
+}
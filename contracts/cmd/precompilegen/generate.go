@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// methodData is the template context for a single ABI method.
+type methodData struct {
+	// GoName is the exported Go method name, e.g. "SubmitProposal".
+	GoName string
+	// Sig is the Solidity method signature, e.g. "vote(uint64,uint8,string)".
+	Sig string
+	// Params are the method's input parameters, in order.
+	Params []paramData
+}
+
+// paramData is the template context for a single ABI method parameter.
+type paramData struct {
+	Name   string
+	GoType string
+}
+
+// contractData is the template context for the generated contract.go file.
+type contractData struct {
+	Package        string
+	Module         string
+	Address        string
+	BindingsImport string
+	Methods        []methodData
+}
+
+// Run loads abiPath and configPath, and writes the generated contract + test skeleton into
+// outDir.
+func Run(abiPath string, configPath string, outDir string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	contractABI, err := loadABI(abiPath)
+	if err != nil {
+		return err
+	}
+
+	data := contractData{
+		Package:        cfg.Package,
+		Module:         cfg.Module,
+		Address:        cfg.Address,
+		BindingsImport: cfg.BindingsImport,
+		Methods:        methodsFromABI(contractABI),
+	}
+
+	if err = os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	if err = renderTemplate(contractTemplate, data, filepath.Join(outDir, cfg.Package+".go")); err != nil {
+		return fmt.Errorf("generating contract: %w", err)
+	}
+
+	if err = renderTemplate(bindingsTestTemplate, data, filepath.Join(outDir, "bindings_test.go")); err != nil {
+		return fmt.Errorf("generating bindings test: %w", err)
+	}
+
+	return nil
+}
+
+// loadABI reads and parses the ABI file at path.
+func loadABI(path string) (abi.ABI, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("opening abi file: %w", err)
+	}
+	defer f.Close()
+
+	contractABI, err := abi.JSON(f)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("parsing abi file: %w", err)
+	}
+
+	return contractABI, nil
+}
+
+// methodsFromABI converts every method in contractABI into the template's methodData, sorted by
+// name so that generation is deterministic.
+func methodsFromABI(contractABI abi.ABI) []methodData {
+	methods := make([]methodData, 0, len(contractABI.Methods))
+	for _, m := range contractABI.Methods {
+		params := make([]paramData, len(m.Inputs))
+		for i, in := range m.Inputs {
+			name := in.Name
+			if name == "" {
+				name = fmt.Sprintf("arg%d", i)
+			}
+			params[i] = paramData{Name: name, GoType: goType(in.Type)}
+		}
+
+		methods = append(methods, methodData{
+			GoName: exportName(m.Name),
+			Sig:    m.Sig,
+			Params: params,
+		})
+	}
+
+	sort.Slice(methods, func(i, j int) bool { return methods[i].GoName < methods[j].GoName })
+	return methods
+}
+
+// goType maps an ABI type to the Go type `validateReturnTypes`/`buildIdsToMethods` expect a
+// stateful precompile method to unpack it as.
+func goType(t abi.Type) string {
+	switch t.T {
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.StringTy:
+		return "string"
+	case abi.BoolTy:
+		return "bool"
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size)
+	case abi.UintTy, abi.IntTy:
+		prefix := "uint"
+		if t.T == abi.IntTy {
+			prefix = "int"
+		}
+
+		// go-ethereum's `abi.Unpack` only produces a native Go int type for these four exact
+		// widths; every other width (e.g. uint24, uint40, uint48) unpacks as `*big.Int`.
+		switch t.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%s%d", prefix, t.Size)
+		default:
+			return "*big.Int"
+		}
+	case abi.SliceTy:
+		return "[]" + goType(*t.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]%s", t.Size, goType(*t.Elem))
+	case abi.TupleTy:
+		return t.TupleRawName
+	default:
+		return "any"
+	}
+}
+
+// exportName converts an ABI method name (camelCase) to an exported Go identifier (PascalCase).
+func exportName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// renderTemplate executes tmplSrc against data and gofmt's the result into path.
+func renderTemplate(tmplSrc string, data contractData, path string) error {
+	tmpl, err := template.New(filepath.Base(path)).Parse(tmplSrc)
+	if err != nil {
+		return fmt.Errorf("parsing template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("executing template: %w", err)
+	}
+
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		// write the unformatted output so the generated code can still be inspected/fixed.
+		formatted = []byte(buf.String())
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Command precompilegen generates the Go scaffolding for a new stateful precompile from an
+// ABI and a small config describing the module it wraps.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path to the .abi file for the precompile (required)")
+	configPath := flag.String("config", "", "path to the precompilegen YAML config (required)")
+	outDir := flag.String("out", ".", "directory to write the generated Go files into")
+	flag.Parse()
+
+	if *abiPath == "" || *configPath == "" {
+		fmt.Fprintln(os.Stderr, "precompilegen: both -abi and -config are required")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if err := Run(*abiPath, *configPath, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "precompilegen: %v\n", err)
+		os.Exit(1)
+	}
+}
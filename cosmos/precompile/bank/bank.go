@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the bank module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	msgServer banktypes.MsgServer
+	querier   banktypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the bank module precompile contract.
+func NewPrecompileContract(m banktypes.MsgServer, q banktypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.BankModuleMetaData.ABI,
+			common.BytesToAddress([]byte{0x67}),
+		),
+		msgServer: m,
+		querier:   q,
+	}
+}
+
+// CustomValueDecoders overrides the `coreprecompile.StatefulImpl` interface. The bank module's
+// `transfer` event is decoded into an EVM `Transfer(address,address,uint256)` log so that
+// block explorers built for ERC-20s can index Cosmos-denom balance movements.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		banktypes.AttributeKeySender:    log.ConvertAccAddressFromBech32,
+		banktypes.AttributeKeyRecipient: log.ConvertAccAddressFromBech32,
+	}
+}
+
+// Send is the precompile contract method for the `send(address,string,uint256)` method.
+func (c *Contract) Send(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	to common.Address,
+	denom string,
+	amount *big.Int,
+) ([]any, error) {
+	return c.sendHelper(
+		ctx, sdk.AccAddress(caller.Bytes()), sdk.AccAddress(to.Bytes()), denom, amount,
+	)
+}
+
+// Balance is the precompile contract method for the `balance(address,string)` method.
+func (c *Contract) Balance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	account common.Address,
+	denom string,
+) ([]any, error) {
+	return c.balanceHelper(ctx, sdk.AccAddress(account.Bytes()), denom)
+}
+
+// Supply is the precompile contract method for the `supply(string)` method.
+func (c *Contract) Supply(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	denom string,
+) ([]any, error) {
+	return c.supplyHelper(ctx, denom)
+}
+
+// DenomMetadata is the precompile contract method for the `denomMetadata(string)` method.
+func (c *Contract) DenomMetadata(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	denom string,
+) ([]any, error) {
+	return c.denomMetadataHelper(ctx, denom)
+}
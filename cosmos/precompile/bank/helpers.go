@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendHelper builds and dispatches a `MsgSend` moving `amount` of `denom` from `from` to `to`.
+func (c *Contract) sendHelper(
+	ctx context.Context, from sdk.AccAddress, to sdk.AccAddress, denom string, amount *big.Int,
+) ([]any, error) {
+	_, err := c.msgServer.Send(ctx, banktypes.NewMsgSend(
+		from, to, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount))),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// balanceHelper queries the balance of `denom` held by `account`.
+func (c *Contract) balanceHelper(ctx context.Context, account sdk.AccAddress, denom string) ([]any, error) {
+	res, err := c.querier.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Balance.Amount.BigInt()}, nil
+}
+
+// supplyHelper queries the total supply of `denom`.
+func (c *Contract) supplyHelper(ctx context.Context, denom string) ([]any, error) {
+	res, err := c.querier.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Amount.Amount.BigInt()}, nil
+}
+
+// denomMetadataHelper queries the bank module's metadata for `denom`.
+func (c *Contract) denomMetadataHelper(ctx context.Context, denom string) ([]any, error) {
+	res, err := c.querier.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Metadata.Name, res.Metadata.Symbol, res.Metadata.Description}, nil
+}
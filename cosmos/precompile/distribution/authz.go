@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// withdrawRewardMsgTypeURL is the msg type url authorized by `approve`/`allowance` for
+// delegated reward withdrawals.
+var withdrawRewardMsgTypeURL = sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{})
+
+// dispatchWithdrawRewardAuthz looks up the `GenericAuthorization` granted by `delegator` to
+// `grantee` for `MsgWithdrawDelegatorReward`, and, if found, dispatches the withdrawal for
+// `validator` through it. `DispatchActions` both authorizes and executes the message, so this is
+// the sole execution path for the `caller != delegator` case — callers must not also invoke
+// `withdrawDelegatorRewardsHelper` afterwards.
+func (c *Contract) dispatchWithdrawRewardAuthz(
+	ctx context.Context, delegator sdk.AccAddress, grantee sdk.AccAddress, validator sdk.ValAddress,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	grant, _ := c.authzKeeper.GetCleanAuthorization(sdkCtx, grantee, delegator, withdrawRewardMsgTypeURL)
+	if grant == nil {
+		return authz.ErrNoAuthorizationFound
+	}
+
+	_, err := c.authzKeeper.DispatchActions(sdkCtx, grantee, []sdk.Msg{
+		distributiontypes.NewMsgWithdrawDelegatorReward(delegator, validator),
+	})
+	return err
+}
+
+// Approve is the precompile contract method for the `approve(address,string,uint256)` method. It
+// grants `grantee` a `GenericAuthorization` to withdraw delegator rewards on the caller's behalf, or,
+// if `amount` is zero, revokes any such grant — mirroring ERC-20 `approve(spender, 0)` as the
+// idiomatic way to clear an allowance. `msgTypeURL` is restricted to `withdrawRewardMsgTypeURL`;
+// this precompile only ever dispatches withdrawals through the grant, so there is nothing else safe
+// to authorize here.
+func (c *Contract) Approve(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	grantee common.Address,
+	msgTypeURL string,
+	amount *big.Int,
+) ([]any, error) {
+	if msgTypeURL != withdrawRewardMsgTypeURL {
+		return nil, fmt.Errorf("approve: unsupported msg type url %q", msgTypeURL)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	granterAddr := sdk.AccAddress(caller.Bytes())
+	granteeAddr := sdk.AccAddress(grantee.Bytes())
+
+	if amount == nil || amount.Sign() == 0 {
+		if err := c.authzKeeper.DeleteGrant(sdkCtx, granteeAddr, granterAddr, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		return []any{true}, nil
+	}
+
+	err := c.authzKeeper.SaveGrant(
+		sdkCtx, granteeAddr, granterAddr, authz.NewGenericAuthorization(msgTypeURL), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// Allowance is the precompile contract method for the `allowance(address,address,string)` method.
+// It reports whether `grantee` currently holds a live authorization from `delegator`.
+func (c *Contract) Allowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	delegator common.Address,
+	grantee common.Address,
+	msgTypeURL string,
+) ([]any, error) {
+	if msgTypeURL != withdrawRewardMsgTypeURL {
+		return []any{false}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	grant, _ := c.authzKeeper.GetCleanAuthorization(
+		sdkCtx, sdk.AccAddress(grantee.Bytes()), sdk.AccAddress(delegator.Bytes()), msgTypeURL,
+	)
+
+	return []any{grant != nil}, nil
+}
@@ -25,6 +25,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/distribution"
@@ -39,6 +40,16 @@ type Contract struct {
 
 	msgServer distributiontypes.MsgServer
 	querier   distributiontypes.QueryServer
+
+	// authzKeeper is optional and only required to service withdrawals on behalf of a
+	// delegator that has not called the precompile itself. See SetAuthzKeeper.
+	authzKeeper authzkeeper.Keeper
+}
+
+// SetAuthzKeeper wires an authz keeper into the contract, enabling `caller != delegator`
+// withdrawals via authz grants. It is a no-op to build the contract without calling this.
+func (c *Contract) SetAuthzKeeper(ak authzkeeper.Keeper) {
+	c.authzKeeper = ak
 }
 
 // NewPrecompileContract returns a new instance of the distribution module precompile contract.
@@ -84,14 +95,28 @@ func (c *Contract) GetWithdrawEnabled(
 }
 
 // WithdrawDelegatorReward is the precompile contract method for the `withdrawDelegatorReward(address,address)`
-// method.
+// method. If `caller` is not `delegator`, the withdrawal is only dispatched if `delegator` has
+// granted `caller` a `GenericAuthorization` for `MsgWithdrawDelegatorReward` via authz.
 func (c *Contract) WithdrawDelegatorReward(
 	ctx context.Context,
 	_ ethprecompile.EVM,
-	_ common.Address,
+	caller common.Address,
 	_ *big.Int,
 	delegator common.Address,
 	validator common.Address,
 ) ([]any, error) {
-	return c.withdrawDelegatorRewardsHelper(ctx, sdk.AccAddress(delegator.Bytes()), sdk.ValAddress(validator.Bytes()))
+	delegatorAddr := sdk.AccAddress(delegator.Bytes())
+	validatorAddr := sdk.ValAddress(validator.Bytes())
+
+	if caller != delegator {
+		if err := c.dispatchWithdrawRewardAuthz(
+			ctx, delegatorAddr, sdk.AccAddress(caller.Bytes()), validatorAddr,
+		); err != nil {
+			return nil, err
+		}
+
+		return []any{true}, nil
+	}
+
+	return c.withdrawDelegatorRewardsHelper(ctx, delegatorAddr, validatorAddr)
 }
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gov
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/gov"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the gov module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	msgServer govtypes.MsgServer
+	querier   govtypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the gov module precompile contract.
+func NewPrecompileContract(m govtypes.MsgServer, q govtypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.GovModuleMetaData.ABI,
+			common.BytesToAddress([]byte{0x68}),
+		),
+		msgServer: m,
+		querier:   q,
+	}
+}
+
+// CustomValueDecoders overrides the `coreprecompile.StatefulImpl` interface.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		govtypes.AttributeKeyVoter:     log.ConvertAccAddressFromBech32,
+		govtypes.AttributeKeyProposer:  log.ConvertAccAddressFromBech32,
+		govtypes.AttributeKeyDepositor: log.ConvertAccAddressFromBech32,
+	}
+}
+
+// SubmitProposal is the precompile contract method for the
+// `submitProposal(bytes,string,uint256,string)` method.
+func (c *Contract) SubmitProposal(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	msgs []byte,
+	metadata string,
+	initialDeposit *big.Int,
+	denom string,
+) ([]any, error) {
+	return c.submitProposalHelper(
+		ctx, sdk.AccAddress(caller.Bytes()), msgs, metadata, initialDeposit, denom,
+	)
+}
+
+// Vote is the precompile contract method for the `vote(uint64,uint8,string)` method.
+func (c *Contract) Vote(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	proposalID uint64,
+	option uint8,
+	metadata string,
+) ([]any, error) {
+	return c.voteHelper(ctx, sdk.AccAddress(caller.Bytes()), proposalID, option, metadata)
+}
+
+// VoteWeighted is the precompile contract method for the
+// `voteWeighted(uint64,(uint8,uint256)[],string)` method.
+func (c *Contract) VoteWeighted(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	proposalID uint64,
+	options []generated.WeightedVoteOption,
+	metadata string,
+) ([]any, error) {
+	return c.voteWeightedHelper(ctx, sdk.AccAddress(caller.Bytes()), proposalID, options, metadata)
+}
+
+// Deposit is the precompile contract method for the `deposit(uint64,uint256,string)` method.
+func (c *Contract) Deposit(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	proposalID uint64,
+	amount *big.Int,
+	denom string,
+) ([]any, error) {
+	return c.depositHelper(ctx, sdk.AccAddress(caller.Bytes()), proposalID, amount, denom)
+}
+
+// Proposal is the precompile contract method for the `proposal(uint64)` method.
+func (c *Contract) Proposal(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	proposalID uint64,
+) ([]any, error) {
+	return c.proposalHelper(ctx, proposalID)
+}
+
+// Proposals is the precompile contract method for the
+// `proposals(uint32,address,address,(bytes,uint64,bool,bool))` method. It returns the matching
+// proposal ids alongside the `nextKey` page token, which the caller must pass back in as
+// `pagination.key` to fetch the next page.
+func (c *Contract) Proposals(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	status uint32,
+	voter common.Address,
+	depositor common.Address,
+	pagination generated.PageRequest,
+) ([]any, error) {
+	return c.proposalsHelper(ctx, status, sdk.AccAddress(voter.Bytes()), sdk.AccAddress(depositor.Bytes()), pagination)
+}
+
+// TallyResult is the precompile contract method for the `tallyResult(uint64)` method.
+func (c *Contract) TallyResult(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	proposalID uint64,
+) ([]any, error) {
+	return c.tallyResultHelper(ctx, proposalID)
+}
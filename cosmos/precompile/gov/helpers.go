@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gov
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/gov"
+)
+
+// submitProposalHelper builds and dispatches a `MsgSubmitProposal` from the caller.
+func (c *Contract) submitProposalHelper(
+	ctx context.Context,
+	proposer sdk.AccAddress,
+	msgs []byte,
+	metadata string,
+	initialDeposit *big.Int,
+	denom string,
+) ([]any, error) {
+	sdkMsgs, err := unpackProposalMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.msgServer.SubmitProposal(ctx, &govtypes.MsgSubmitProposal{
+		Messages:       sdkMsgs,
+		InitialDeposit: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(initialDeposit))),
+		Proposer:       proposer.String(),
+		Metadata:       metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.ProposalId}, nil
+}
+
+// voteHelper builds and dispatches a `MsgVote`.
+func (c *Contract) voteHelper(
+	ctx context.Context, voter sdk.AccAddress, proposalID uint64, option uint8, metadata string,
+) ([]any, error) {
+	_, err := c.msgServer.Vote(ctx, &govtypes.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Option:     govtypes.VoteOption(option),
+		Metadata:   metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// voteWeightedHelper builds and dispatches a `MsgVoteWeighted`.
+func (c *Contract) voteWeightedHelper(
+	ctx context.Context,
+	voter sdk.AccAddress,
+	proposalID uint64,
+	options []generated.WeightedVoteOption,
+	metadata string,
+) ([]any, error) {
+	weighted, err := normalizeWeightedOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.msgServer.VoteWeighted(ctx, &govtypes.MsgVoteWeighted{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Options:    weighted,
+		Metadata:   metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// normalizeWeightedOptions converts the caller-supplied relative integer weights into the exact
+// decimal fractions `x/gov` requires: `ValidWeightedVoteOptions` rejects a weighted vote unless its
+// option weights sum to precisely 1.00. Each option's weight is `opt.Weight / total`, except the
+// last, which takes whatever remains, so rounding in the intermediate divisions can never leave the
+// sum off of 1.00.
+func normalizeWeightedOptions(options []generated.WeightedVoteOption) ([]*govtypes.WeightedVoteOption, error) {
+	if len(options) == 0 {
+		return nil, errors.New("voteWeighted: no options given")
+	}
+
+	total := new(big.Int)
+	for _, opt := range options {
+		total.Add(total, opt.Weight)
+	}
+	if total.Sign() <= 0 {
+		return nil, errors.New("voteWeighted: total weight must be positive")
+	}
+	totalDec := sdk.NewDecFromBigInt(total)
+
+	weighted := make([]*govtypes.WeightedVoteOption, len(options))
+	remaining := sdk.OneDec()
+	for i, opt := range options {
+		weight := remaining
+		if i < len(options)-1 {
+			weight = sdk.NewDecFromBigInt(opt.Weight).Quo(totalDec)
+			remaining = remaining.Sub(weight)
+		}
+
+		weighted[i] = &govtypes.WeightedVoteOption{
+			Option: govtypes.VoteOption(opt.Option),
+			Weight: weight.String(),
+		}
+	}
+
+	return weighted, nil
+}
+
+// depositHelper builds and dispatches a `MsgDeposit`.
+func (c *Contract) depositHelper(
+	ctx context.Context, depositor sdk.AccAddress, proposalID uint64, amount *big.Int, denom string,
+) ([]any, error) {
+	_, err := c.msgServer.Deposit(ctx, &govtypes.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// proposalHelper queries a single proposal by id.
+func (c *Contract) proposalHelper(ctx context.Context, proposalID uint64) ([]any, error) {
+	res, err := c.querier.Proposal(ctx, &govtypes.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Proposal.Status.String(), res.Proposal.Metadata}, nil
+}
+
+// proposalsHelper queries proposals matching the given status/voter/depositor filters.
+func (c *Contract) proposalsHelper(
+	ctx context.Context,
+	status uint32,
+	voter sdk.AccAddress,
+	depositor sdk.AccAddress,
+	pagination generated.PageRequest,
+) ([]any, error) {
+	res, err := c.querier.Proposals(ctx, &govtypes.QueryProposalsRequest{
+		ProposalStatus: govtypes.ProposalStatus(status),
+		Voter:          voter.String(),
+		Depositor:      depositor.String(),
+		Pagination: &query.PageRequest{
+			Key:        pagination.Key,
+			Offset:     pagination.Offset,
+			Limit:      pagination.Limit,
+			CountTotal: pagination.CountTotal,
+			Reverse:    pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(res.Proposals))
+	for i, p := range res.Proposals {
+		ids[i] = p.Id
+	}
+
+	var nextKey []byte
+	if res.Pagination != nil {
+		nextKey = res.Pagination.NextKey
+	}
+
+	return []any{ids, nextKey}, nil
+}
+
+// tallyResultHelper queries the current tally result of a proposal.
+func (c *Contract) tallyResultHelper(ctx context.Context, proposalID uint64) ([]any, error) {
+	res, err := c.querier.TallyResult(ctx, &govtypes.QueryTallyResultRequest{ProposalId: proposalID})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Tally.YesCount, res.Tally.NoCount, res.Tally.AbstainCount, res.Tally.NoWithVetoCount}, nil
+}
+
+// unpackProposalMsgs decodes the ABI-encoded `bytes msgs` parameter, a single proto-marshaled
+// `codectypes.Any`, into the one-message list a `MsgSubmitProposal` expects to execute once the
+// proposal passes.
+func unpackProposalMsgs(msgs []byte) ([]*codectypes.Any, error) {
+	packedMsg := new(codectypes.Any)
+	if err := packedMsg.Unmarshal(msgs); err != nil {
+		return nil, err
+	}
+
+	return []*codectypes.Any{packedMsg}, nil
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// transferHelper builds and dispatches a `MsgTransfer` and returns the outgoing packet sequence.
+func (c *Contract) transferHelper(
+	ctx context.Context,
+	sourcePort string,
+	sourceChannel string,
+	denom string,
+	amount *big.Int,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) ([]any, error) {
+	res, err := c.msgServer.Transfer(ctx, ibctransfertypes.NewMsgTransfer(
+		sourcePort,
+		sourceChannel,
+		sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)),
+		sender.String(),
+		receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+		memo,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Sequence}, nil
+}
+
+// denomTraceHelper queries the denom trace for the given ibc denom hash.
+func (c *Contract) denomTraceHelper(ctx context.Context, hash string) ([]any, error) {
+	res, err := c.transferQuerier.DenomTrace(ctx, &ibctransfertypes.QueryDenomTraceRequest{
+		Hash: hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.DenomTrace.Path, res.DenomTrace.BaseDenom}, nil
+}
+
+// channelStateHelper queries the state of the channel identified by the given port/channel pair.
+func (c *Contract) channelStateHelper(ctx context.Context, port string, channel string) ([]any, error) {
+	res, err := c.channelQuerier.Channel(ctx, &channeltypes.QueryChannelRequest{
+		PortId:    port,
+		ChannelId: channel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{res.Channel.State.String()}, nil
+}
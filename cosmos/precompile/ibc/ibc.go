@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ibc"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the IBC transfer module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	msgServer       ibctransfertypes.MsgServer
+	transferQuerier ibctransfertypes.QueryServer
+	channelQuerier  channeltypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the IBC transfer module precompile contract.
+func NewPrecompileContract(
+	m ibctransfertypes.MsgServer, tq ibctransfertypes.QueryServer, cq channeltypes.QueryServer,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.IbcModuleMetaData.ABI,
+			common.BytesToAddress([]byte{0x66}),
+		),
+		msgServer:       m,
+		transferQuerier: tq,
+		channelQuerier:  cq,
+	}
+}
+
+// CustomValueDecoders overrides the `coreprecompile.StatefulImpl` interface.
+func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
+	return ethprecompile.ValueDecoders{
+		ibctransfertypes.AttributeKeySender:   log.ConvertAccAddressFromBech32,
+		ibctransfertypes.AttributeKeyReceiver: log.ConvertAccAddressFromBech32,
+	}
+}
+
+// Transfer is the precompile contract method for the
+// `transfer(string,string,string,uint256,string,uint64,uint64,string)` method. The sender of the
+// outgoing packet is always `caller`, translated to `sdk.AccAddress` the same way
+// `distribution.WithdrawDelegatorReward` translates its delegator — a contract cannot move funds
+// out of an account it does not control.
+func (c *Contract) Transfer(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	sourcePort string,
+	sourceChannel string,
+	denom string,
+	amount *big.Int,
+	receiver string,
+	timeoutHeight uint64,
+	timeoutTimestamp uint64,
+	memo string,
+) ([]any, error) {
+	return c.transferHelper(
+		ctx,
+		sourcePort,
+		sourceChannel,
+		denom,
+		amount,
+		sdk.AccAddress(caller.Bytes()),
+		receiver,
+		clienttypes.NewHeight(0, timeoutHeight),
+		timeoutTimestamp,
+		memo,
+	)
+}
+
+// DenomTrace is the precompile contract method for the `denomTrace(string)` method.
+func (c *Contract) DenomTrace(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	hash string,
+) ([]any, error) {
+	return c.denomTraceHelper(ctx, hash)
+}
+
+// ChannelState is the precompile contract method for the `channelState(string,string)` method.
+func (c *Contract) ChannelState(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	port string,
+	channel string,
+) ([]any, error) {
+	return c.channelStateHelper(ctx, port, channel)
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package staking
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// delegateMsgTypeURL and undelegateMsgTypeURL are the msg type urls authorized by
+// `approve`/`allowance` for delegating/undelegating on behalf of another account.
+var (
+	delegateMsgTypeURL   = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	undelegateMsgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+)
+
+// maxApprovalAmount is returned by `Allowance` for a `StakeAuthorization` with a nil `MaxTokens`,
+// which `x/authz` treats as an unbounded grant — mirroring the ERC-20 convention of reporting
+// `type(uint256).max` for an unlimited allowance.
+var maxApprovalAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// consumeStakeAuthz looks up the `StakeAuthorization` (or `GenericAuthorization`) granted by
+// `delegator` to `grantee` for `msgTypeURL`, and dispatches `msg` through it, decrementing the
+// grant's remaining allowance in the process.
+func (c *Contract) consumeStakeAuthz(
+	ctx context.Context, delegator sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string, msg sdk.Msg,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	grant, _ := c.authzKeeper.GetCleanAuthorization(sdkCtx, grantee, delegator, msgTypeURL)
+	if grant == nil {
+		return authz.ErrNoAuthorizationFound
+	}
+
+	_, err := c.authzKeeper.DispatchActions(sdkCtx, grantee, []sdk.Msg{msg})
+	return err
+}
+
+// Delegate0 implements the `delegate(address,address,uint256)` method, delegating on behalf of
+// `delegator` when `caller` holds a `StakeAuthorization` grant from `delegator`.
+func (c *Contract) Delegate0(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	delegator common.Address,
+	validator common.Address,
+	amount *big.Int,
+) ([]any, error) {
+	delegatorAddr := cosmlib.AddressToAccAddress(delegator)
+	validatorAddr := cosmlib.AddressToValAddress(validator)
+
+	if caller != delegator {
+		coin, err := c.bondDenomCoin(ctx, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.consumeStakeAuthz(
+			ctx, delegatorAddr, cosmlib.AddressToAccAddress(caller), delegateMsgTypeURL,
+			stakingtypes.NewMsgDelegate(delegatorAddr, validatorAddr, coin),
+		); err != nil {
+			return nil, err
+		}
+
+		return []any{true}, nil
+	}
+
+	return c.delegateHelper(ctx, caller, amount, validatorAddr)
+}
+
+// Undelegate0 implements the `undelegate(address,address,uint256)` method, undelegating on
+// behalf of `delegator` when `caller` holds a `StakeAuthorization` grant from `delegator`.
+func (c *Contract) Undelegate0(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	delegator common.Address,
+	validator common.Address,
+	amount *big.Int,
+) ([]any, error) {
+	delegatorAddr := cosmlib.AddressToAccAddress(delegator)
+	validatorAddr := cosmlib.AddressToValAddress(validator)
+
+	if caller != delegator {
+		coin, err := c.bondDenomCoin(ctx, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.consumeStakeAuthz(
+			ctx, delegatorAddr, cosmlib.AddressToAccAddress(caller), undelegateMsgTypeURL,
+			stakingtypes.NewMsgUndelegate(delegatorAddr, validatorAddr, coin),
+		); err != nil {
+			return nil, err
+		}
+
+		return []any{true}, nil
+	}
+
+	return c.undelegateHelper(ctx, caller, amount, validatorAddr)
+}
+
+// Approve is the precompile contract method for the `approve(address,string,uint256)` method. It
+// grants `grantee` a `StakeAuthorization` to delegate/undelegate up to `amount` of the bond denom
+// on the caller's behalf, scoped to `msgTypeURL`.
+func (c *Contract) Approve(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	grantee common.Address,
+	msgTypeURL string,
+	amount *big.Int,
+) ([]any, error) {
+	var authzType stakingtypes.AuthorizationType
+	switch msgTypeURL {
+	case delegateMsgTypeURL:
+		authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+	case undelegateMsgTypeURL:
+		authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE
+	default:
+		return nil, fmt.Errorf("approve: unsupported msg type url %q", msgTypeURL)
+	}
+
+	coin, err := c.bondDenomCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	stakeAuthz, err := stakingtypes.NewStakeAuthorization(nil, nil, authzType, &coin)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = c.authzKeeper.SaveGrant(
+		sdkCtx, cosmlib.AddressToAccAddress(grantee), cosmlib.AddressToAccAddress(caller), stakeAuthz, nil,
+	); err != nil {
+		return nil, err
+	}
+
+	return []any{true}, nil
+}
+
+// Allowance is the precompile contract method for the `allowance(address,address,string)` method.
+// It reports the remaining `StakeAuthorization.MaxTokens` amount `grantee` may still delegate or
+// undelegate on `delegator`'s behalf, `maxApprovalAmount` for an unbounded grant, or zero if no live
+// authorization exists.
+func (c *Contract) Allowance(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	delegator common.Address,
+	grantee common.Address,
+	msgTypeURL string,
+) ([]any, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	grant, _ := c.authzKeeper.GetCleanAuthorization(
+		sdkCtx, cosmlib.AddressToAccAddress(grantee), cosmlib.AddressToAccAddress(delegator), msgTypeURL,
+	)
+	if grant == nil {
+		return []any{big.NewInt(0)}, nil
+	}
+
+	stakeAuthz, ok := grant.(*stakingtypes.StakeAuthorization)
+	if !ok || stakeAuthz.MaxTokens == nil {
+		return []any{maxApprovalAmount}, nil
+	}
+
+	return []any{stakeAuthz.MaxTokens.Amount.BigInt()}, nil
+}
+
+// bondDenomCoin builds an `sdk.Coin` of the staking module's bond denom for `amount`.
+func (c *Contract) bondDenomCoin(ctx context.Context, amount *big.Int) (sdk.Coin, error) {
+	res, err := c.querier.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(res.Params.BondDenom, sdk.NewIntFromBigInt(amount)), nil
+}
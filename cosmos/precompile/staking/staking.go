@@ -26,6 +26,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -43,6 +44,17 @@ type Contract struct {
 
 	msgServer stakingtypes.MsgServer
 	querier   stakingtypes.QueryServer
+
+	// authzKeeper is optional and only required to service delegations/undelegations on
+	// behalf of a delegator that has not called the precompile itself. See SetAuthzKeeper.
+	authzKeeper authzkeeper.Keeper
+}
+
+// SetAuthzKeeper wires an authz keeper into the contract, enabling `caller != delegator`
+// staking actions via `StakeAuthorization` grants. It is a no-op to build the contract without
+// calling this.
+func (c *Contract) SetAuthzKeeper(ak authzkeeper.Keeper) {
+	c.authzKeeper = ak
 }
 
 // NewContract is the constructor of the staking contract.
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank_test
+
+import (
+	"testing"
+
+	tbindings "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
+	"pkg.berachain.dev/polaris/cosmos/testing/integration"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "pkg.berachain.dev/polaris/cosmos/testing/integration/utils"
+)
+
+func TestBankPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/testing/integration/precompile/bank")
+}
+
+// bankPrecompileAddress is the fixed address the bank precompile registers itself at, see
+// `bank.NewPrecompileContract`.
+var bankPrecompileAddress = common.BytesToAddress([]byte{0x67})
+
+var tf *integration.TestFixture
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	tf = integration.NewTestFixture(GinkgoT())
+	return nil
+}, func(data []byte) {})
+
+var _ = Describe("Bank Precompile", func() {
+	Describe("moving an arbitrary Cosmos denom", func() {
+		It("should send and reflect the new balance", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewBankModule(bankPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			before, err := contract.Balance(nil, tf.Address("bob"), "abera")
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.Send(txr, tf.Address("bob"), "abera", Big1)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+
+			after, err := contract.Balance(nil, tf.Address("bob"), "abera")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(after.Cmp(before)).To(Equal(1))
+		})
+
+		It("should error sending an unknown denom", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewBankModule(bankPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = contract.Send(txr, tf.Address("bob"), "notadenom", Big1)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("querying denom info", func() {
+		It("should return the total supply of a denom", func() {
+			contract, err := tbindings.NewBankModule(bankPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			supply, err := contract.Supply(nil, "abera")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supply).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gov_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tbindings "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/gov"
+	"pkg.berachain.dev/polaris/cosmos/testing/integration"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "pkg.berachain.dev/polaris/cosmos/testing/integration/utils"
+)
+
+func TestGovPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/testing/integration/precompile/gov")
+}
+
+// govPrecompileAddress is the fixed address the gov precompile registers itself at, see
+// `gov.NewPrecompileContract`.
+var govPrecompileAddress = common.BytesToAddress([]byte{0x68})
+
+var tf *integration.TestFixture
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	tf = integration.NewTestFixture(GinkgoT())
+	return nil
+}, func(data []byte) {})
+
+var _ = Describe("Gov Precompile", func() {
+	Describe("submitting and acting on a proposal", func() {
+		// proposalID is set by "should submit a proposal" and reused by every test below it,
+		// since ginkgo runs specs within an Ordered/Describe container in declaration order.
+		var proposalID uint64
+
+		It("should submit a proposal", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewGovModule(govPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			packedMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+				FromAddress: tf.Bech32Address("alice"),
+				ToAddress:   tf.Bech32Address("bob"),
+				Amount:      nil,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			msgBytes, err := packedMsg.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.SubmitProposal(txr, msgBytes, "test proposal", Big1, "abera")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+
+			// the chain is fresh for this suite, so this is the first proposal submitted.
+			proposalID = 1
+
+			proposal, err := contract.Proposal(nil, proposalID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proposal.Metadata).ToNot(BeEmpty())
+		})
+
+		It("should accept a deposit", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewGovModule(govPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.Deposit(txr, proposalID, Big1, "abera")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+		})
+
+		It("should record a weighted vote", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewGovModule(govPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.VoteWeighted(txr, proposalID, []tbindings.WeightedVoteOption{
+				{Option: 1, Weight: Big1},
+			}, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+
+			tally, err := contract.TallyResult(nil, proposalID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tally.YesCount).ToNot(BeEmpty())
+		})
+
+		It("should list the proposal back via proposals query", func() {
+			contract, err := tbindings.NewGovModule(govPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			ids, nextKey, err := contract.Proposals(
+				nil, uint32(0), tf.Address("alice"), tf.Address("alice"), tbindings.PageRequest{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ContainElement(proposalID))
+			Expect(nextKey).To(BeEmpty())
+		})
+	})
+})
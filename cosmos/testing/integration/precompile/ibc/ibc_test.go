@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ibc_test
+
+import (
+	"testing"
+
+	tbindings "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ibc"
+	"pkg.berachain.dev/polaris/cosmos/testing/integration"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "pkg.berachain.dev/polaris/cosmos/testing/integration/utils"
+)
+
+func TestIBCPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/testing/integration/precompile/ibc")
+}
+
+// ibcPrecompileAddress is the fixed address the ibc precompile registers itself at, see
+// `ibc.NewPrecompileContract`.
+var ibcPrecompileAddress = common.BytesToAddress([]byte{0x66})
+
+var tf *integration.TestFixture
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	// Setup the network, with two channels opened between chain A and chain B.
+	tf = integration.NewTestFixture(GinkgoT())
+	return nil
+}, func(data []byte) {})
+
+var _ = Describe("IBC Transfer Precompile", func() {
+	Describe("transferring over two channels", func() {
+		It("should commit the outgoing packet on channel-0", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewIbcModule(ibcPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.Transfer(
+				txr,
+				"transfer",
+				"channel-0",
+				"abera",
+				Big1,
+				tf.Bech32Address("bob"),
+				uint64(0),
+				uint64(0),
+				"",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+		})
+
+		It("should commit the outgoing packet on channel-1", func() {
+			txr := tf.GenerateTransactOpts("alice")
+			contract, err := tbindings.NewIbcModule(ibcPrecompileAddress, tf.EthClient)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err := contract.Transfer(
+				txr,
+				"transfer",
+				"channel-1",
+				"abera",
+				Big1,
+				tf.Bech32Address("bob"),
+				uint64(0),
+				uint64(0),
+				"",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = tf.Network.WaitForNextBlock()
+			Expect(err).ToNot(HaveOccurred())
+			ExpectSuccessReceipt(tf.EthClient, tx)
+		})
+	})
+})
@@ -110,6 +110,12 @@ func (sf *StatefulFactory) Build(
 		return nil, err
 	}
 
+	// every stateful contract built through this factory also gains a synthetic `multicall`
+	// entry that atomically dispatches a batch of calls against idsToMethods.
+	idsToMethods[utils.UnsafeBytesToStr(multicallMethod.ID)] = buildMulticallMethod(
+		idsToMethods, reflect.ValueOf(sci),
+	)
+
 	return NewStateful(rp, idsToMethods)
 }
 
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// MulticallArg mirrors the Solidity tuple `(bytes4 selector, bytes callData)`: one inner call to
+// dispatch as part of a `multicall`.
+type MulticallArg struct {
+	Selector [4]byte
+	CallData []byte
+}
+
+// MulticallResult mirrors the Solidity tuple `(bool success, bytes returnData)`: the outcome of
+// one inner call dispatched as part of a `multicall`.
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicallMethod is the fixed ABI method every stateful precompile gains through
+// `StatefulFactory.Build`, regardless of what the underlying contract declares in its own ABI.
+var multicallMethod = newMulticallABIMethod()
+
+// newMulticallABIMethod constructs the `multicall((bytes4,bytes)[])` ABI method used to compute
+// the synthetic multicall selector and to decode/encode its arguments and return value.
+func newMulticallABIMethod() abi.Method {
+	callsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "selector", Type: "bytes4"},
+		{Name: "callData", Type: "bytes"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	resultsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "success", Type: "bool"},
+		{Name: "returnData", Type: "bytes"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.NewMethod(
+		"multicall", "multicall", abi.Function, "nonpayable", false, false,
+		abi.Arguments{{Type: callsType, Name: "calls"}},
+		abi.Arguments{{Type: resultsType, Name: "results"}},
+	)
+}
+
+// buildMulticallMethod returns the `*Method` backing the synthetic `multicall` entry. Its handler
+// ABI-decodes the call array, looks each inner selector up in idsToMethods, and invokes the
+// matching implementation's Func against contractImpl, sharing the same `sdk.Context` across the
+// whole batch. The first inner call to error aborts and fails the entire batch.
+func buildMulticallMethod(idsToMethods map[string]*Method, contractImpl reflect.Value) *Method {
+	handler := func(
+		_ any, ctx context.Context, evm EVM, caller common.Address, value *big.Int, args ...any,
+	) ([]any, error) {
+		calls, ok := utils.GetAs[[]MulticallArg](args[0])
+		if !ok {
+			// `abi.Unpack` decodes the tuple array into anonymous structs rather than MulticallArg,
+			// so copy it over field by field.
+			converted, isCalls := abi.ConvertType(args[0], new([]MulticallArg)).(*[]MulticallArg)
+			if !isCalls {
+				return nil, fmt.Errorf("multicall: expected []MulticallArg, got %T", args[0])
+			}
+			calls = *converted
+		}
+
+		results := make([]MulticallResult, 0, len(calls))
+		for _, call := range calls {
+			// multicall is injected into idsToMethods alongside every other method, so without
+			// this guard a call could recurse into itself with no depth limit (the EVM's own
+			// call-depth limiter never sees this, since we never re-enter evm.Call).
+			if string(call.Selector[:]) == utils.UnsafeBytesToStr(multicallMethod.ID) {
+				return nil, errorslib.Wrap(ErrNoPrecompileMethodForABIMethod, "multicall: nested multicall is not allowed")
+			}
+
+			m, found := idsToMethods[utils.UnsafeBytesToStr(call.Selector[:])]
+			if !found {
+				return nil, errorslib.Wrap(ErrNoPrecompileMethodForABIMethod, fmt.Sprintf("%x", call.Selector))
+			}
+
+			callArgs, err := m.AbiMethod.Inputs.Unpack(call.CallData)
+			if err != nil {
+				return nil, err
+			}
+
+			// reflect.ValueOf of a nil EVM is the zero Value, which Call rejects, so take the EVM
+			// through a pointer to keep its interface type.
+			in := []reflect.Value{
+				contractImpl, reflect.ValueOf(ctx), reflect.ValueOf(&evm).Elem(), reflect.ValueOf(caller), reflect.ValueOf(value),
+			}
+			for _, a := range callArgs {
+				in = append(in, reflect.ValueOf(a))
+			}
+
+			out := m.Func.Call(in)
+			if errVal := out[1].Interface(); errVal != nil {
+				return nil, errVal.(error) //nolint:forcetypeassert // validated by validateReturnTypes.
+			}
+
+			returnData, err := m.AbiMethod.Outputs.Pack(out[0].Interface().([]any)...) //nolint:forcetypeassert // same.
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, MulticallResult{Success: true, ReturnData: returnData})
+		}
+
+		return []any{results}, nil
+	}
+
+	return NewMethod(&multicallMethod, multicallMethod.Sig, reflect.ValueOf(handler))
+}
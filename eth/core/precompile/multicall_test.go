@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// fakeMulticallContract is a minimal stateful precompile used to exercise
+// `buildMulticallMethod` against real `reflect`/ABI plumbing, without a live EVM or precompile
+// plugin.
+type fakeMulticallContract struct {
+	// calls records the order in which the contract's own methods were invoked, so a test can
+	// assert that a failing call aborted the batch before any later call ran.
+	calls []string
+}
+
+// Add implements the `add(uint256,uint256)` method: it returns x+y and always succeeds.
+func (c *fakeMulticallContract) Add(
+	_ context.Context, _ EVM, _ common.Address, _ *big.Int, x *big.Int, y *big.Int,
+) ([]any, error) {
+	c.calls = append(c.calls, "add")
+	return []any{new(big.Int).Add(x, y)}, nil
+}
+
+// Fail implements the `fail(uint256)` method: it always errors, to exercise the partial-batch
+// abort path.
+func (c *fakeMulticallContract) Fail(
+	_ context.Context, _ EVM, _ common.Address, _ *big.Int, _ *big.Int,
+) ([]any, error) {
+	c.calls = append(c.calls, "fail")
+	return nil, errors.New("fail: always errors")
+}
+
+// newFakeMulticallABI builds the two-method ABI matching `fakeMulticallContract`.
+func newFakeMulticallABI(t *testing.T) map[string]abi.Method {
+	t.Helper()
+
+	uint256Ty, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addMethod := abi.NewMethod(
+		"add", "add", abi.Function, "nonpayable", false, false,
+		abi.Arguments{{Name: "x", Type: uint256Ty}, {Name: "y", Type: uint256Ty}},
+		abi.Arguments{{Name: "sum", Type: uint256Ty}},
+	)
+	failMethod := abi.NewMethod(
+		"fail", "fail", abi.Function, "nonpayable", false, false,
+		abi.Arguments{{Name: "x", Type: uint256Ty}},
+		abi.Arguments{{Name: "out", Type: uint256Ty}},
+	)
+
+	return map[string]abi.Method{"add": addMethod, "fail": failMethod}
+}
+
+// callMulticall invokes the built `multicall` method's handler directly through its Func, passing
+// the calls as an already-typed []MulticallArg rather than as ABI-decoded input.
+func callMulticall(m *Method, calls []MulticallArg) ([]MulticallResult, error) {
+	in := []reflect.Value{
+		reflect.ValueOf(struct{}{}),
+		reflect.ValueOf(context.Background()),
+		reflect.Zero(reflect.TypeOf((*EVM)(nil)).Elem()),
+		reflect.ValueOf(common.Address{}),
+		reflect.ValueOf(big.NewInt(0)),
+		reflect.ValueOf(calls),
+	}
+
+	out := m.Func.Call(in)
+	if errVal := out[1].Interface(); errVal != nil {
+		return nil, errVal.(error) //nolint:forcetypeassert // validated by validateReturnTypes.
+	}
+
+	return out[0].Interface().([]any)[0].([]MulticallResult), nil //nolint:forcetypeassert // test-only.
+}
+
+func TestMulticallBatchSucceeds(t *testing.T) {
+	contract := &fakeMulticallContract{}
+	idsToMethods, err := buildIdsToMethods(newFakeMulticallABI(t), reflect.ValueOf(contract))
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi := buildMulticallMethod(idsToMethods, reflect.ValueOf(contract))
+
+	addMethod := idsToMethods[mustSelector(t, idsToMethods, "add")]
+	addCallData, err := addMethod.AbiMethod.Inputs.Pack(big.NewInt(2), big.NewInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var selector [4]byte
+	copy(selector[:], addMethod.AbiMethod.ID)
+
+	results, err := callMulticall(multi, []MulticallArg{{Selector: selector, CallData: addCallData}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || !results[0].Success {
+		t.Fatalf("expected one successful result, got %+v", results)
+	}
+
+	sum, err := addMethod.AbiMethod.Outputs.Unpack(results[0].ReturnData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sum[0].(*big.Int); got.Cmp(big.NewInt(5)) != 0 { //nolint:forcetypeassert // test-only.
+		t.Fatalf("expected 5, got %s", got)
+	}
+}
+
+func TestMulticallBatchAbortsOnFirstFailure(t *testing.T) {
+	contract := &fakeMulticallContract{}
+	idsToMethods, err := buildIdsToMethods(newFakeMulticallABI(t), reflect.ValueOf(contract))
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi := buildMulticallMethod(idsToMethods, reflect.ValueOf(contract))
+
+	addMethod := idsToMethods[mustSelector(t, idsToMethods, "add")]
+	failMethod := idsToMethods[mustSelector(t, idsToMethods, "fail")]
+
+	addCallData, err := addMethod.AbiMethod.Inputs.Pack(big.NewInt(2), big.NewInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	failCallData, err := failMethod.AbiMethod.Inputs.Pack(big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var addSelector, failSelector [4]byte
+	copy(addSelector[:], addMethod.AbiMethod.ID)
+	copy(failSelector[:], failMethod.AbiMethod.ID)
+
+	_, err = callMulticall(multi, []MulticallArg{
+		{Selector: failSelector, CallData: failCallData},
+		{Selector: addSelector, CallData: addCallData},
+	})
+	if err == nil {
+		t.Fatal("expected the batch to abort on the first failing call")
+	}
+
+	// the batch must abort before the second, otherwise-valid call ever runs.
+	if len(contract.calls) != 1 || contract.calls[0] != "fail" {
+		t.Fatalf("expected only the failing call to run, got %v", contract.calls)
+	}
+}
+
+func TestMulticallRejectsNestedMulticall(t *testing.T) {
+	contract := &fakeMulticallContract{}
+	idsToMethods, err := buildIdsToMethods(newFakeMulticallABI(t), reflect.ValueOf(contract))
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi := buildMulticallMethod(idsToMethods, reflect.ValueOf(contract))
+
+	var nestedSelector [4]byte
+	copy(nestedSelector[:], multicallMethod.ID)
+
+	_, err = callMulticall(multi, []MulticallArg{{Selector: nestedSelector, CallData: nil}})
+	if err == nil {
+		t.Fatal("expected nested multicall to be rejected")
+	}
+}
+
+// mustSelector returns the string key idsToMethods uses for the entry registered as name.
+func mustSelector(t *testing.T, idsToMethods map[string]*Method, name string) string {
+	t.Helper()
+
+	for id, m := range idsToMethods {
+		if m.Sig[:len(name)] == name {
+			return id
+		}
+	}
+
+	t.Fatalf("no method named %q in idsToMethods", name)
+	return ""
+}